@@ -0,0 +1,184 @@
+package goga
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+)
+
+// MigrationTopology reports which islands a given island exchanges
+// migrants with.
+type MigrationTopology interface {
+	Neighbors(island, numIslands int) []int
+}
+
+// RingTopology connects each island to the next one in a circle.
+type RingTopology struct{}
+
+func (RingTopology) Neighbors(island, numIslands int) []int {
+	return []int{(island + 1) % numIslands}
+}
+
+// FullyConnectedTopology connects every island to every other island.
+type FullyConnectedTopology struct{}
+
+func (FullyConnectedTopology) Neighbors(island, numIslands int) []int {
+	neighbors := make([]int, 0, numIslands-1)
+	for i := 0; i < numIslands; i++ {
+		if i != island {
+			neighbors = append(neighbors, i)
+		}
+	}
+	return neighbors
+}
+
+// AdjacencyTopology lets callers supply an arbitrary neighbor graph,
+// indexed by island.
+type AdjacencyTopology [][]int
+
+func (a AdjacencyTopology) Neighbors(island, numIslands int) []int {
+	return a[island]
+}
+
+// ReplacementPolicy decides which members of an island's population are
+// overwritten by incoming migrants.
+type ReplacementPolicy int
+
+const (
+	ReplaceWorst ReplacementPolicy = iota
+	ReplaceRandom
+)
+
+// Island is a single subpopulation within an Archipelago, evolved by its
+// own Goga instance.
+type Island struct {
+	Goga       *Goga
+	Population []Chromosome
+
+	generation   int
+	previousBest float64
+}
+
+// receive overwrites members of the island's population with clones of
+// migrants according to policy. Cloning keeps each island's population
+// independent: without it, a migrant sent to more than one neighbor (or
+// evolved further on its origin island) would be mutated concurrently by
+// more than one goroutine.
+func (isl *Island) receive(migrants []Chromosome, policy ReplacementPolicy) {
+	for _, migrant := range migrants {
+		migrant := migrant.Clone()
+		switch policy {
+		case ReplaceRandom:
+			isl.Population[rand.Intn(len(isl.Population))] = migrant
+		default:
+			worst := 0
+			for i, c := range isl.Population {
+				if isl.Goga.objectiveFitness(c) < isl.Goga.objectiveFitness(isl.Population[worst]) {
+					worst = i
+				}
+			}
+			isl.Population[worst] = migrant
+		}
+	}
+}
+
+// runGenerations advances the island's population by n generations using
+// its own Goga's Selection, Crossover, Mutation, and Learn steps. The
+// island's generation counter and previous-best fitness are carried across
+// calls (runGenerations is re-entered once per MigrationInterval), so
+// generation-dependent MutationRate schedules see the island's true,
+// cumulative generation rather than restarting every interval.
+func (isl *Island) runGenerations(n int, target Chromosome) {
+	g := isl.Goga
+	g.target = target
+	population := g.calculatePopulationFitness(isl.Population)
+
+	for i := 0; i < n; i++ {
+		g.applySpeciation(population)
+		parents := g.Selection(population)
+		parents = g.calculatePopulationFitness(parents)
+		nextGeneration := g.Crossover(parents)
+		nextGeneration = g.Mutation(nextGeneration, g.MutationRate.Rate(isl.generation, isl.previousBest))
+		nextGeneration = g.Learn(nextGeneration)
+
+		population = append(parents, nextGeneration...)
+
+		best := g.getBestChromosome(nextGeneration)
+		isl.previousBest = g.objectiveFitness(best)
+		isl.generation++
+	}
+
+	isl.Population = population
+}
+
+// Archipelago runs several islands concurrently, periodically migrating
+// the fittest individuals between neighboring islands according to
+// Topology. This is coarse-grained parallelism on top of each Goga
+// instance's own per-generation goroutine fan-out, useful for maintaining
+// diversity and scaling beyond a single population.
+type Archipelago struct {
+	Islands           []*Island
+	MigrationInterval int
+	MigrationSize     int
+	Topology          MigrationTopology
+	Replacement       ReplacementPolicy
+	MaxIterations     int
+}
+
+// Run evolves every island for MaxIterations generations, synchronizing at
+// a barrier and migrating the top individuals every MigrationInterval
+// generations.
+func (a *Archipelago) Run(target Chromosome) {
+	interval := a.MigrationInterval
+	if interval <= 0 {
+		interval = 1
+	}
+
+	for gen := 0; gen < a.MaxIterations; {
+		batch := interval
+		if gen+batch > a.MaxIterations {
+			batch = a.MaxIterations - gen
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(len(a.Islands))
+		for _, island := range a.Islands {
+			go func(isl *Island) {
+				defer wg.Done()
+				isl.runGenerations(batch, target)
+			}(island)
+		}
+		wg.Wait() // barrier: every island reaches this generation before migrating
+
+		gen += batch
+		if gen < a.MaxIterations {
+			a.migrate()
+		}
+	}
+}
+
+// migrate copies the top MigrationSize individuals of each island's
+// neighbors, as determined by Topology, into that island according to
+// Replacement. With RingTopology, island i receives from island i+1.
+func (a *Archipelago) migrate() {
+	numIslands := len(a.Islands)
+	emigrants := make([][]Chromosome, numIslands)
+	for i, island := range a.Islands {
+		ranked := make([]Chromosome, len(island.Population))
+		copy(ranked, island.Population)
+		fitness := island.Goga.objectiveFitness
+		sort.Slice(ranked, func(j, k int) bool { return fitness(ranked[j]) > fitness(ranked[k]) })
+
+		k := a.MigrationSize
+		if k > len(ranked) {
+			k = len(ranked)
+		}
+		emigrants[i] = ranked[:k]
+	}
+
+	for i, island := range a.Islands {
+		for _, neighbor := range a.Topology.Neighbors(i, numIslands) {
+			island.receive(emigrants[neighbor], a.Replacement)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package goga
+
+import "testing"
+
+func TestRingTopology_ConnectsToNextIslandOnly(t *testing.T) {
+	r := RingTopology{}
+
+	if got := r.Neighbors(0, 3); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("island 0 of 3: expected [1], got %v", got)
+	}
+	if got := r.Neighbors(2, 3); len(got) != 1 || got[0] != 0 {
+		t.Fatalf("island 2 of 3 (wraps around): expected [0], got %v", got)
+	}
+}
+
+func TestFullyConnectedTopology_ConnectsToEveryOtherIsland(t *testing.T) {
+	f := FullyConnectedTopology{}
+	got := f.Neighbors(1, 4)
+
+	want := map[int]bool{0: true, 2: true, 3: true}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d neighbors, got %v", len(want), got)
+	}
+	for _, n := range got {
+		if !want[n] {
+			t.Fatalf("unexpected neighbor %d, island 1 should not neighbor itself", n)
+		}
+	}
+}
+
+func TestAdjacencyTopology_UsesSuppliedGraph(t *testing.T) {
+	a := AdjacencyTopology{{1, 2}, {0}, {}}
+
+	if got := a.Neighbors(0, 3); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("island 0: expected [1 2], got %v", got)
+	}
+	if got := a.Neighbors(2, 3); len(got) != 0 {
+		t.Fatalf("island 2: expected no neighbors, got %v", got)
+	}
+}
+
+func newSingleSpeciesGoga() *Goga {
+	return &Goga{Objective: Maximize}
+}
+
+func TestIslandReceive_ClonesMigrantsRatherThanAliasing(t *testing.T) {
+	isl := &Island{
+		Goga:       newSingleSpeciesGoga(),
+		Population: []Chromosome{&testChromosome{key: "a", fitness: 1}},
+	}
+	migrant := &testChromosome{key: "b", fitness: 100}
+
+	isl.receive([]Chromosome{migrant}, ReplaceWorst)
+
+	if isl.Population[0] == Chromosome(migrant) {
+		t.Fatalf("expected the island to store a clone of the migrant, not the same pointer")
+	}
+	if isl.Population[0].GetKey() != "b" {
+		t.Fatalf("expected the clone to carry the migrant's data, got key %q", isl.Population[0].GetKey())
+	}
+
+	// Mutating the original migrant afterward must not affect the island's copy.
+	migrant.fitness = -1
+	if isl.Population[0].GetFitness() != 100 {
+		t.Fatalf("expected island's clone to be independent of the original migrant")
+	}
+}
+
+func TestIslandReceive_ReplaceWorstOverwritesLowestObjectiveFitness(t *testing.T) {
+	isl := &Island{
+		Goga: newSingleSpeciesGoga(),
+		Population: []Chromosome{
+			&testChromosome{key: "low", fitness: 1},
+			&testChromosome{key: "high", fitness: 100},
+		},
+	}
+
+	isl.receive([]Chromosome{&testChromosome{key: "migrant", fitness: 50}}, ReplaceWorst)
+
+	if isl.Population[0].GetKey() != "migrant" {
+		t.Fatalf("expected the worst individual (low) to be replaced, population is now %v, %v",
+			isl.Population[0].GetKey(), isl.Population[1].GetKey())
+	}
+	if isl.Population[1].GetKey() != "high" {
+		t.Fatalf("expected the fitter individual to survive, got %q", isl.Population[1].GetKey())
+	}
+}
+
+func newRunnableIsland() *Island {
+	population := make([]Chromosome, 8)
+	for i := range population {
+		population[i] = &testChromosome{key: string(rune('a' + i)), fitness: float64(i + 1)}
+	}
+	return &Island{
+		Goga: &Goga{
+			Objective:    Maximize,
+			Converter:    func(c interface{}) Chromosome { return c.(Chromosome) },
+			Selector:     TournamentSelector{Size: 1},
+			MutationRate: ConstantMutationRate(0),
+		},
+		Population: population,
+	}
+}
+
+func TestArchipelagoRun_ClampsFinalBatchAndDoesNotOvershootMaxIterations(t *testing.T) {
+	island := newRunnableIsland()
+	a := &Archipelago{
+		Islands:           []*Island{island},
+		MigrationInterval: 3, // does not evenly divide MaxIterations
+		MigrationSize:     1,
+		Topology:          RingTopology{},
+		MaxIterations:     10,
+	}
+
+	a.Run(&testChromosome{key: "target"})
+
+	if island.generation != a.MaxIterations {
+		t.Fatalf("expected exactly %d generations to run, got %d", a.MaxIterations, island.generation)
+	}
+}
@@ -0,0 +1,127 @@
+package goga
+
+import "math/rand"
+
+// Gene is a single unit of a SliceChromosome's genome, letting Crossover
+// implementations recombine genomes without knowing their concrete type.
+type Gene interface{}
+
+// SliceChromosome is a Chromosome whose genome can be read as a flat
+// sequence of genes and rebuilt from one, which is what the generic
+// Crossover implementations operate on. SetGenes leaves the receiver
+// unmodified and returns a new Chromosome built from genes.
+type SliceChromosome interface {
+	Chromosome
+	Genes() []Gene
+	SetGenes(genes []Gene) Chromosome
+}
+
+// Crossover recombines two parents into two children.
+type Crossover interface {
+	Cross(mom, dad SliceChromosome) (Chromosome, Chromosome)
+}
+
+// NoCrossover passes both parents through unchanged.
+type NoCrossover struct{}
+
+func (NoCrossover) Cross(mom, dad SliceChromosome) (Chromosome, Chromosome) {
+	return mom, dad
+}
+
+// SinglePointCrossover swaps genes after a single randomly chosen site.
+type SinglePointCrossover struct{}
+
+func (SinglePointCrossover) Cross(mom, dad SliceChromosome) (Chromosome, Chromosome) {
+	momGenes, dadGenes := mom.Genes(), dad.Genes()
+	site := rand.Intn(len(momGenes))
+	return mom.SetGenes(splice(momGenes, dadGenes, site)), dad.SetGenes(splice(dadGenes, momGenes, site))
+}
+
+// splice returns a copy of primary with everything from site onward
+// replaced by secondary's genes.
+func splice(primary, secondary []Gene, site int) []Gene {
+	child := make([]Gene, len(primary))
+	copy(child, primary[:site])
+	copy(child[site:], secondary[site:])
+	return child
+}
+
+// TwoPointCrossover swaps the genes between two randomly chosen sites.
+type TwoPointCrossover struct{}
+
+func (TwoPointCrossover) Cross(mom, dad SliceChromosome) (Chromosome, Chromosome) {
+	momGenes, dadGenes := mom.Genes(), dad.Genes()
+	a, b := rand.Intn(len(momGenes)), rand.Intn(len(momGenes))
+	if a > b {
+		a, b = b, a
+	}
+	return mom.SetGenes(spliceRange(momGenes, dadGenes, a, b)), dad.SetGenes(spliceRange(dadGenes, momGenes, a, b))
+}
+
+// spliceRange returns a copy of primary with genes between a and b
+// replaced by secondary's genes.
+func spliceRange(primary, secondary []Gene, a, b int) []Gene {
+	child := make([]Gene, len(primary))
+	copy(child, primary)
+	copy(child[a:b], secondary[a:b])
+	return child
+}
+
+// UniformCrossover swaps each gene independently with probability Rate.
+type UniformCrossover struct {
+	Rate float64
+}
+
+func (u UniformCrossover) Cross(mom, dad SliceChromosome) (Chromosome, Chromosome) {
+	momGenes, dadGenes := mom.Genes(), dad.Genes()
+	child1 := make([]Gene, len(momGenes))
+	child2 := make([]Gene, len(momGenes))
+	for i := range momGenes {
+		if rand.Float64() < u.Rate {
+			child1[i], child2[i] = dadGenes[i], momGenes[i]
+		} else {
+			child1[i], child2[i] = momGenes[i], dadGenes[i]
+		}
+	}
+	return mom.SetGenes(child1), dad.SetGenes(child2)
+}
+
+// OrderCrossover is the order crossover (OX) operator for permutation
+// genomes: a slice of one parent is copied verbatim, and the remaining
+// positions are filled with the other parent's genes in the order they
+// appear, skipping genes already placed. Genes must be comparable.
+type OrderCrossover struct{}
+
+func (OrderCrossover) Cross(mom, dad SliceChromosome) (Chromosome, Chromosome) {
+	momGenes, dadGenes := mom.Genes(), dad.Genes()
+	n := len(momGenes)
+	a, b := rand.Intn(n), rand.Intn(n)
+	if a > b {
+		a, b = b, a
+	}
+	return mom.SetGenes(order(momGenes, dadGenes, a, b)), dad.SetGenes(order(dadGenes, momGenes, a, b))
+}
+
+// order builds one OX child: primary[a:b] is copied verbatim, and the
+// remaining positions are filled in the order secondary's genes appear,
+// skipping any gene already placed.
+func order(primary, secondary []Gene, a, b int) []Gene {
+	n := len(primary)
+	child := make([]Gene, n)
+	used := make(map[Gene]bool, n)
+	for i := a; i < b; i++ {
+		child[i] = primary[i]
+		used[primary[i]] = true
+	}
+
+	pos := b % n
+	for _, gene := range secondary {
+		if used[gene] {
+			continue
+		}
+		child[pos] = gene
+		used[gene] = true
+		pos = (pos + 1) % n
+	}
+	return child
+}
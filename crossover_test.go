@@ -0,0 +1,90 @@
+package goga
+
+import "testing"
+
+func geneChromosome(key string, genes ...Gene) *testChromosome {
+	return &testChromosome{key: key, genes: genes}
+}
+
+func TestNoCrossover_PassesParentsThroughUnchanged(t *testing.T) {
+	mom := geneChromosome("mom", 1, 2, 3)
+	dad := geneChromosome("dad", 4, 5, 6)
+
+	c1, c2 := NoCrossover{}.Cross(mom, dad)
+
+	if c1 != Chromosome(mom) || c2 != Chromosome(dad) {
+		t.Fatalf("expected NoCrossover to return the parents unchanged")
+	}
+}
+
+func TestSinglePointCrossover_ProducesFullLengthChildren(t *testing.T) {
+	mom := geneChromosome("mom", 1, 2, 3, 4)
+	dad := geneChromosome("dad", 5, 6, 7, 8)
+
+	c1, c2 := SinglePointCrossover{}.Cross(mom, dad)
+
+	g1 := c1.(SliceChromosome).Genes()
+	g2 := c2.(SliceChromosome).Genes()
+	if len(g1) != 4 || len(g2) != 4 {
+		t.Fatalf("expected full-length children, got lengths %d and %d", len(g1), len(g2))
+	}
+}
+
+func TestTwoPointCrossover_ProducesFullLengthChildren(t *testing.T) {
+	mom := geneChromosome("mom", 1, 2, 3, 4, 5)
+	dad := geneChromosome("dad", 6, 7, 8, 9, 10)
+
+	c1, c2 := TwoPointCrossover{}.Cross(mom, dad)
+
+	g1 := c1.(SliceChromosome).Genes()
+	g2 := c2.(SliceChromosome).Genes()
+	if len(g1) != 5 || len(g2) != 5 {
+		t.Fatalf("expected full-length children, got lengths %d and %d", len(g1), len(g2))
+	}
+}
+
+func TestUniformCrossover_ChildrenAreComplementaryAndFullLength(t *testing.T) {
+	mom := geneChromosome("mom", 1, 2, 3, 4)
+	dad := geneChromosome("dad", 5, 6, 7, 8)
+
+	c1, c2 := UniformCrossover{Rate: 0.5}.Cross(mom, dad)
+	g1 := c1.(SliceChromosome).Genes()
+	g2 := c2.(SliceChromosome).Genes()
+
+	if len(g1) != 4 || len(g2) != 4 {
+		t.Fatalf("expected full-length children, got lengths %d and %d", len(g1), len(g2))
+	}
+	for i := range g1 {
+		// Every position is either (mom[i], dad[i]) or (dad[i], mom[i]).
+		momGene, dadGene := mom.genes[i], dad.genes[i]
+		ok := (g1[i] == momGene && g2[i] == dadGene) || (g1[i] == dadGene && g2[i] == momGene)
+		if !ok {
+			t.Fatalf("position %d: expected a swap of mom/dad genes, got %v/%v", i, g1[i], g2[i])
+		}
+	}
+}
+
+func TestOrderCrossover_ProducesValidPermutations(t *testing.T) {
+	mom := geneChromosome("mom", 1, 2, 3, 4, 5)
+	dad := geneChromosome("dad", 5, 4, 3, 2, 1)
+
+	for trial := 0; trial < 20; trial++ {
+		c1, c2 := OrderCrossover{}.Cross(mom, dad)
+		for _, child := range []SliceChromosome{c1.(SliceChromosome), c2.(SliceChromosome)} {
+			genes := child.Genes()
+			if len(genes) != 5 {
+				t.Fatalf("expected a full-length child, got %d genes", len(genes))
+			}
+			seen := make(map[Gene]bool, len(genes))
+			for _, g := range genes {
+				if seen[g] {
+					t.Fatalf("expected a valid permutation, found duplicate gene %v in %v", g, genes)
+				}
+				seen[g] = true
+			}
+			if len(seen) != 5 {
+				t.Fatalf("expected all 5 distinct genes present, got %v", genes)
+			}
+		}
+	}
+}
@@ -55,19 +55,11 @@ func (m *Member) CalculateFitness(t interface{}) {
 	m.Fitness = float64(len(m.Value)) - float64(edits)
 }
 
-func (m *Member) Normalize(total float64) {
-	m.Fitness = m.Fitness / total
-}
-
-func (m *Member) AccNormalize(accumulation float64) {
-	m.Fitness = accumulation
-}
-
-func (m *Member) Mutate() {
+func (m *Member) Mutate(p float64) {
 	alphabet := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v", "w", "x", "y", "z"}
 	value := m.Value
 	for _, c := range value {
-		if rand.Float64() > .8 {
+		if rand.Float64() < p {
 			m.Value = strings.Replace(value, string(c), alphabet[rand.Intn(len(alphabet))], 1)
 		}
 	}
@@ -77,6 +69,18 @@ func (m *Member) GetFitness() float64 {
 	return m.Fitness
 }
 
+func (m *Member) Clone() goga.Chromosome {
+	clone := *m
+	return &clone
+}
+
+func (m *Member) GetKey() string {
+	return m.Value
+}
+
+func (m *Member) Learn() {
+}
+
 func (mom *Member) Breed(daddy interface{}) (interface{}, interface{}) {
 	dad, _ := daddy.(*Member)
 	crossoverSite := rand.Intn(len(dad.Value))
@@ -0,0 +1,43 @@
+package goga
+
+// testChromosome is a minimal Chromosome (and SliceChromosome) used by this
+// package's tests in place of a real genome.
+type testChromosome struct {
+	key     string
+	fitness float64
+	good    bool
+	genes   []Gene
+}
+
+func (c *testChromosome) Breed(other interface{}) (interface{}, interface{}) {
+	o := other.(*testChromosome)
+	return &testChromosome{key: c.key + "+" + o.key}, &testChromosome{key: o.key + "+" + c.key}
+}
+
+func (c *testChromosome) CalculateFitness(target interface{}) {}
+
+func (c *testChromosome) Clone() Chromosome {
+	clone := *c
+	clone.genes = append([]Gene(nil), c.genes...)
+	return &clone
+}
+
+func (c *testChromosome) GetFitness() float64 { return c.fitness }
+
+func (c *testChromosome) GetKey() string { return c.key }
+
+func (c *testChromosome) IsGoodEnough() bool { return c.good }
+
+func (c *testChromosome) Mutate(p float64) {}
+
+func (c *testChromosome) Learn() {}
+
+func (c *testChromosome) String() string { return c.key }
+
+func (c *testChromosome) Genes() []Gene { return c.genes }
+
+func (c *testChromosome) SetGenes(genes []Gene) Chromosome {
+	return &testChromosome{key: c.key, genes: genes}
+}
+
+func identityFitness(c Chromosome) float64 { return c.GetFitness() }
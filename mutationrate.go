@@ -0,0 +1,69 @@
+package goga
+
+// MutationRate computes the per-gene mutation probability to use for a
+// generation, given the best fitness observed in the previous one.
+// previousBest is always objective-mapped (see Goga.objectiveFitness), so
+// "improvement" consistently means a higher value regardless of whether
+// the run is maximizing or minimizing.
+type MutationRate interface {
+	Rate(gen int, previousBest float64) float64
+}
+
+// ConstantMutationRate always returns the same probability, matching
+// Goga's original fixed-rate behavior.
+type ConstantMutationRate float64
+
+func (r ConstantMutationRate) Rate(gen int, previousBest float64) float64 {
+	return float64(r)
+}
+
+// LinearDecayMutationRate decays linearly from Start towards End over
+// Generations generations, then holds steady at End.
+type LinearDecayMutationRate struct {
+	Start       float64
+	End         float64
+	Generations int
+}
+
+func (r LinearDecayMutationRate) Rate(gen int, previousBest float64) float64 {
+	if r.Generations <= 0 || gen >= r.Generations {
+		return r.End
+	}
+	progress := float64(gen) / float64(r.Generations)
+	return r.Start - (r.Start-r.End)*progress
+}
+
+// DiversityMutationRate raises the mutation rate by Step each generation the
+// best fitness fails to improve, and resets it to Base as soon as the
+// population improves again, capping at Max. This helps the population
+// escape premature convergence without a fixed schedule.
+type DiversityMutationRate struct {
+	Base float64
+	Max  float64
+	Step float64
+
+	started bool
+	best    float64
+	current float64
+}
+
+func (r *DiversityMutationRate) Rate(gen int, previousBest float64) float64 {
+	if !r.started {
+		r.started = true
+		r.best = previousBest
+		r.current = r.Base
+		return r.current
+	}
+
+	if previousBest > r.best {
+		r.best = previousBest
+		r.current = r.Base
+	} else {
+		r.current += r.Step
+		if r.current > r.Max {
+			r.current = r.Max
+		}
+	}
+
+	return r.current
+}
@@ -0,0 +1,63 @@
+package goga
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConstantMutationRate_NeverChanges(t *testing.T) {
+	r := ConstantMutationRate(0.2)
+	for gen := 0; gen < 5; gen++ {
+		if got := r.Rate(gen, float64(gen)); got != 0.2 {
+			t.Fatalf("gen %d: expected 0.2, got %v", gen, got)
+		}
+	}
+}
+
+func TestLinearDecayMutationRate_DecaysThenHolds(t *testing.T) {
+	r := LinearDecayMutationRate{Start: 1.0, End: 0.0, Generations: 10}
+
+	if got := r.Rate(0, 0); got != 1.0 {
+		t.Fatalf("gen 0: expected Start (1.0), got %v", got)
+	}
+	if got := r.Rate(5, 0); got != 0.5 {
+		t.Fatalf("gen 5: expected midpoint (0.5), got %v", got)
+	}
+	if got := r.Rate(10, 0); got != 0.0 {
+		t.Fatalf("gen 10: expected End (0.0) once Generations reached, got %v", got)
+	}
+	if got := r.Rate(20, 0); got != 0.0 {
+		t.Fatalf("gen 20: expected to hold at End past Generations, got %v", got)
+	}
+}
+
+func TestDiversityMutationRate_RampsUpOnStagnationAndResetsOnImprovement(t *testing.T) {
+	r := &DiversityMutationRate{Base: 0.1, Max: 0.5, Step: 0.1}
+
+	if got := r.Rate(0, 1.0); got != 0.1 {
+		t.Fatalf("first call: expected Base (0.1), got %v", got)
+	}
+	if got := r.Rate(1, 1.0); got != 0.2 {
+		t.Fatalf("no improvement: expected rate to step up to 0.2, got %v", got)
+	}
+	// 0.2+0.1 is not exactly 0.3 in float64, so compare within rounding error.
+	if got := r.Rate(2, 1.0); math.Abs(got-0.3) > 1e-12 {
+		t.Fatalf("still no improvement: expected 0.3, got %v", got)
+	}
+	if got := r.Rate(3, 2.0); got != 0.1 {
+		t.Fatalf("improvement (higher objective-mapped fitness): expected reset to Base (0.1), got %v", got)
+	}
+}
+
+func TestDiversityMutationRate_CapsAtMax(t *testing.T) {
+	r := &DiversityMutationRate{Base: 0.1, Max: 0.25, Step: 0.1}
+
+	r.Rate(0, 1.0)
+	r.Rate(1, 1.0) // 0.2
+	if got := r.Rate(2, 1.0); got != 0.25 {
+		t.Fatalf("expected rate capped at Max (0.25) instead of overshooting to 0.3, got %v", got)
+	}
+	if got := r.Rate(3, 1.0); got != 0.25 {
+		t.Fatalf("expected rate to stay capped at Max (0.25), got %v", got)
+	}
+}
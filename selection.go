@@ -0,0 +1,171 @@
+package goga
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Selector chooses n individuals from a population to serve as parents for
+// the next generation. fitness reports an individual's selection weight,
+// already mapped onto a maximization scale according to the Goga's
+// Objective.
+type Selector interface {
+	Select(population []Chromosome, n int, fitness func(Chromosome) float64) []Chromosome
+}
+
+// RouletteSelector is fitness-proportionate (roulette-wheel) selection: an
+// individual's chance of being picked is proportional to its share of the
+// population's total fitness. This is Goga's original selection strategy
+// and remains the default.
+//
+// Selection is computed once per call against an immutable snapshot of the
+// population's fitness values, and sampling is with replacement, as a
+// proper roulette wheel allows the same individual to be drawn more than
+// once.
+type RouletteSelector struct{}
+
+func (RouletteSelector) Select(population []Chromosome, n int, fitness func(Chromosome) float64) []Chromosome {
+	if n <= 0 || len(population) == 0 {
+		return nil
+	}
+
+	sum := 0.0
+	cumulative := make([]float64, len(population))
+	for i, c := range population {
+		sum += fitness(c)
+		cumulative[i] = sum
+	}
+
+	selected := make([]Chromosome, 0, n)
+	for len(selected) < n {
+		r := rand.Float64() * sum
+		i := sort.Search(len(cumulative), func(i int) bool { return cumulative[i] > r })
+		if i == len(cumulative) {
+			i = len(cumulative) - 1
+		}
+		selected = append(selected, population[i])
+	}
+	return selected
+}
+
+// TournamentSelector selects an individual by drawing Size random
+// candidates from the population and keeping the fittest, repeated n times.
+type TournamentSelector struct {
+	Size int
+}
+
+func (t TournamentSelector) Select(population []Chromosome, n int, fitness func(Chromosome) float64) []Chromosome {
+	size := t.Size
+	if size < 1 {
+		size = 2
+	}
+
+	selected := make([]Chromosome, 0, n)
+	for i := 0; i < n; i++ {
+		best := population[rand.Intn(len(population))]
+		for j := 1; j < size; j++ {
+			candidate := population[rand.Intn(len(population))]
+			if fitness(candidate) > fitness(best) {
+				best = candidate
+			}
+		}
+		selected = append(selected, best)
+	}
+	return selected
+}
+
+// SUSSelector implements stochastic universal sampling: a single random
+// offset r in [0, 1/n) followed by n equally spaced pointers over the
+// cumulative-fitness wheel, giving an unbiased sample in one pass.
+type SUSSelector struct{}
+
+func (SUSSelector) Select(population []Chromosome, n int, fitness func(Chromosome) float64) []Chromosome {
+	if n <= 0 || len(population) == 0 {
+		return nil
+	}
+
+	sum := 0.0
+	for _, c := range population {
+		sum += fitness(c)
+	}
+	if sum == 0 {
+		sum = 1
+	}
+
+	cumulative := make([]float64, len(population))
+	acc := 0.0
+	for i, c := range population {
+		acc += fitness(c) / sum
+		cumulative[i] = acc
+	}
+
+	step := 1.0 / float64(n)
+	pointer := rand.Float64() * step
+
+	selected := make([]Chromosome, 0, n)
+	i := 0
+	for len(selected) < n {
+		for i < len(cumulative)-1 && cumulative[i] < pointer {
+			i++
+		}
+		selected = append(selected, population[i])
+		pointer += step
+	}
+	return selected
+}
+
+// RankSelector assigns selection probability based on an individual's rank
+// within the sorted population rather than its raw fitness, so that a
+// handful of outliers can't dominate selection.
+type RankSelector struct{}
+
+func (RankSelector) Select(population []Chromosome, n int, fitness func(Chromosome) float64) []Chromosome {
+	ranked := make([]Chromosome, len(population))
+	copy(ranked, population)
+	sort.Slice(ranked, func(i, j int) bool { return fitness(ranked[i]) < fitness(ranked[j]) }) // rank 1 is the least fit
+
+	total := float64(len(ranked)*(len(ranked)+1)) / 2
+
+	selected := make([]Chromosome, 0, n)
+	for len(selected) < n {
+		r := rand.Float64() * total
+		acc := 0.0
+		for i, c := range ranked {
+			acc += float64(i + 1)
+			if acc >= r {
+				selected = append(selected, c)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// ElitismSelector wraps another Selector, guaranteeing that the top K
+// individuals of the population survive into the result unchanged before
+// the underlying Selector fills the rest.
+type ElitismSelector struct {
+	K        int
+	Selector Selector
+}
+
+func (e ElitismSelector) Select(population []Chromosome, n int, fitness func(Chromosome) float64) []Chromosome {
+	elite := make([]Chromosome, len(population))
+	copy(elite, population)
+	sort.Slice(elite, func(i, j int) bool { return fitness(elite[i]) > fitness(elite[j]) })
+
+	k := e.K
+	if k > n {
+		k = n
+	}
+	if k > len(elite) {
+		k = len(elite)
+	}
+
+	selected := make([]Chromosome, 0, n)
+	selected = append(selected, elite[:k]...)
+	if k < n {
+		selected = append(selected, e.Selector.Select(population, n-k, fitness)...)
+	}
+	return selected
+}
@@ -0,0 +1,137 @@
+package goga
+
+import "testing"
+
+func makeRankedPopulation(n int) []Chromosome {
+	population := make([]Chromosome, n)
+	for i := 0; i < n; i++ {
+		population[i] = &testChromosome{key: string(rune('a' + i)), fitness: float64(i + 1)}
+	}
+	return population
+}
+
+func TestTournamentSelector_LargeTournamentAlwaysPicksFittest(t *testing.T) {
+	population := makeRankedPopulation(5)
+	// Candidates are drawn with replacement, so a tournament only as large as
+	// the population can miss the fittest; with 200 draws the chance of
+	// missing it is 0.8^200 (about 4e-20) per pick.
+	selector := TournamentSelector{Size: 200}
+
+	for trial := 0; trial < 20; trial++ {
+		selected := selector.Select(population, 3, identityFitness)
+		if len(selected) != 3 {
+			t.Fatalf("expected 3 selected, got %d", len(selected))
+		}
+		for _, c := range selected {
+			if c.GetKey() != "e" { // fitness 5 is the fittest
+				t.Fatalf("expected the fittest individual every time, got %q", c.GetKey())
+			}
+		}
+	}
+}
+
+func TestSUSSelector_ReturnsExactlyN(t *testing.T) {
+	population := makeRankedPopulation(7)
+	for _, n := range []int{1, 3, 7, 10} {
+		selected := SUSSelector{}.Select(population, n, identityFitness)
+		if len(selected) != n {
+			t.Errorf("n=%d: expected %d selected, got %d", n, n, len(selected))
+		}
+	}
+}
+
+func TestSUSSelector_EqualFitnessSelectsEachExactlyOnce(t *testing.T) {
+	population := make([]Chromosome, 5)
+	for i := range population {
+		population[i] = &testChromosome{key: string(rune('a' + i)), fitness: 1}
+	}
+
+	for trial := 0; trial < 20; trial++ {
+		selected := SUSSelector{}.Select(population, len(population), identityFitness)
+		seen := make(map[string]int)
+		for _, c := range selected {
+			seen[c.GetKey()]++
+		}
+		if len(seen) != len(population) {
+			t.Fatalf("expected every equally-fit individual to be picked exactly once, got %v", seen)
+		}
+		for key, count := range seen {
+			if count != 1 {
+				t.Fatalf("expected %q to be picked exactly once, got %d", key, count)
+			}
+		}
+	}
+}
+
+func TestRankSelector_GivesWeakerIndividualsAChance(t *testing.T) {
+	population := makeRankedPopulation(10) // fitness 1..10, heavily skewed if selected by raw fitness
+	seen := make(map[string]bool)
+
+	for trial := 0; trial < 200; trial++ {
+		selected := RankSelector{}.Select(population, 1, identityFitness)
+		seen[selected[0].GetKey()] = true
+	}
+
+	if len(seen) <= 1 {
+		t.Fatalf("expected rank selection to pick more than one individual across trials, got %v", seen)
+	}
+}
+
+func TestRouletteSelector_ReturnsExactlyNAndDoesNotMutateInput(t *testing.T) {
+	population := makeRankedPopulation(5)
+	original := make([]Chromosome, len(population))
+	copy(original, population)
+
+	selected := RouletteSelector{}.Select(population, 8, identityFitness)
+	if len(selected) != 8 {
+		t.Fatalf("expected 8 selected (sampling with replacement), got %d", len(selected))
+	}
+
+	for i, c := range population {
+		if c != original[i] {
+			t.Fatalf("expected population order/contents untouched, index %d changed", i)
+		}
+	}
+}
+
+func TestRouletteSelector_RespectsObjective(t *testing.T) {
+	population := makeRankedPopulation(5) // fitness 1..5
+	weightFor := func(objective Objective) func(Chromosome) float64 {
+		return func(c Chromosome) float64 { return fitnessFor(objective, c) }
+	}
+
+	seenMax := make(map[string]bool)
+	for trial := 0; trial < 200; trial++ {
+		selected := RouletteSelector{}.Select(population, 1, weightFor(Maximize))
+		seenMax[selected[0].GetKey()] = true
+	}
+	if !seenMax["e"] {
+		t.Fatalf("expected the fittest individual to be selectable under Maximize, saw %v", seenMax)
+	}
+
+	// Under Minimize, low raw fitness maps to a high weight, so the
+	// weakest-by-Maximize individual ("a", fitness 1) should dominate selection.
+	seenMin := make(map[string]int)
+	for trial := 0; trial < 200; trial++ {
+		selected := RouletteSelector{}.Select(population, 1, weightFor(Minimize))
+		seenMin[selected[0].GetKey()]++
+	}
+	if seenMin["a"] <= seenMin["e"] {
+		t.Fatalf("expected Minimize to favor the lowest raw fitness, got counts %v", seenMin)
+	}
+}
+
+func TestElitismSelector_PreservesTopKUnchanged(t *testing.T) {
+	population := makeRankedPopulation(5) // fitness 1..5
+	elitism := ElitismSelector{K: 2, Selector: TournamentSelector{Size: 1}}
+
+	selected := elitism.Select(population, 4, identityFitness)
+	if len(selected) != 4 {
+		t.Fatalf("expected 4 selected, got %d", len(selected))
+	}
+
+	// The two fittest (fitness 5 and 4) must survive untouched as the first two entries.
+	if selected[0].GetKey() != "e" || selected[1].GetKey() != "d" {
+		t.Fatalf("expected top 2 elites (e, d) first, got (%s, %s)", selected[0].GetKey(), selected[1].GetKey())
+	}
+}
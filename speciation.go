@@ -0,0 +1,73 @@
+package goga
+
+// DistanceFunc measures the genetic distance between two chromosomes, used
+// to group the population into species for fitness sharing.
+type DistanceFunc func(a, b Chromosome) float64
+
+// Species is a cluster of chromosomes considered similar enough (within
+// SpeciationThreshold of each other) to compete for the same ecological
+// niche.
+type Species struct {
+	Representative Chromosome
+	Members        []Chromosome
+}
+
+// Best returns the fittest member of the species according to objective,
+// e.g. g.Objective.
+func (s Species) Best(objective Objective) Chromosome {
+	best := s.Members[0]
+	for _, c := range s.Members {
+		if fitnessFor(objective, c) > fitnessFor(objective, best) {
+			best = c
+		}
+	}
+	return best
+}
+
+// Size returns the number of members in the species.
+func (s Species) Size() int {
+	return len(s.Members)
+}
+
+// speciate clusters population into species by walking it in order and
+// assigning each chromosome to the first species whose representative is
+// within g.SpeciationThreshold distance, creating a new species otherwise.
+func (g *Goga) speciate(population []Chromosome) []Species {
+	var species []Species
+	for _, c := range population {
+		placed := false
+		for i := range species {
+			if g.DistanceFunc(species[i].Representative, c) < g.SpeciationThreshold {
+				species[i].Members = append(species[i].Members, c)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			species = append(species, Species{Representative: c, Members: []Chromosome{c}})
+		}
+	}
+	return species
+}
+
+// applySpeciation groups population into g.Species and records each
+// member's sharing divisor, so that selectionWeight can apply explicit
+// fitness sharing when a DistanceFunc is configured.
+func (g *Goga) applySpeciation(population []Chromosome) {
+	if g.DistanceFunc == nil {
+		g.Species = nil
+		g.shareDivisor = nil
+		return
+	}
+
+	species := g.speciate(population)
+	divisor := make(map[string]float64, len(population))
+	for _, s := range species {
+		for _, c := range s.Members {
+			divisor[c.GetKey()] = float64(len(s.Members))
+		}
+	}
+
+	g.Species = species
+	g.shareDivisor = divisor
+}
@@ -0,0 +1,80 @@
+package goga
+
+import "testing"
+
+func keyDistance(a, b Chromosome) float64 {
+	ak, bk := []rune(a.GetKey())[0], []rune(b.GetKey())[0]
+	d := ak - bk
+	if d < 0 {
+		d = -d
+	}
+	return float64(d)
+}
+
+func TestSpeciate_ClustersByThreshold(t *testing.T) {
+	g := &Goga{DistanceFunc: keyDistance, SpeciationThreshold: 1}
+	// The two "a"s are distance 0 apart (< threshold), so they cluster
+	// together; "z" is 25 away from "a", so it forms its own species.
+	population := []Chromosome{
+		&testChromosome{key: "a"},
+		&testChromosome{key: "a"},
+		&testChromosome{key: "z"},
+	}
+
+	species := g.speciate(population)
+	if len(species) != 2 {
+		t.Fatalf("expected 2 species (two 'a's together, 'z' alone), got %d: %+v", len(species), species)
+	}
+	sizes := map[int]bool{species[0].Size(): true, species[1].Size(): true}
+	if !sizes[2] || !sizes[1] {
+		t.Fatalf("expected species sizes {2,1}, got %d and %d", species[0].Size(), species[1].Size())
+	}
+}
+
+func TestApplySpeciation_NoDistanceFuncClearsState(t *testing.T) {
+	g := &Goga{}
+	population := []Chromosome{&testChromosome{key: "a"}, &testChromosome{key: "b"}}
+
+	g.applySpeciation(population)
+
+	if g.Species != nil || g.shareDivisor != nil {
+		t.Fatalf("expected no speciation state without a DistanceFunc, got Species=%v shareDivisor=%v", g.Species, g.shareDivisor)
+	}
+}
+
+func TestApplySpeciation_SharingPenalizesCrowdedSpeciesInSelectionOnly(t *testing.T) {
+	g := &Goga{DistanceFunc: keyDistance, SpeciationThreshold: 1, Objective: Maximize}
+
+	crowded1 := &testChromosome{key: "a1", fitness: 10}
+	crowded2 := &testChromosome{key: "a2", fitness: 10}
+	lone := &testChromosome{key: "z", fitness: 10}
+	population := []Chromosome{crowded1, crowded2, lone}
+
+	g.applySpeciation(population)
+
+	// Same raw fitness, but the crowded pair should have a lower selection
+	// weight than the lone individual once fitness sharing is applied...
+	if g.selectionWeight(crowded1) >= g.selectionWeight(lone) {
+		t.Fatalf("expected fitness sharing to penalize the crowded species relative to the lone one")
+	}
+	// ...while objectiveFitness (used for getBestChromosome/migration) must
+	// stay untouched by sharing.
+	if g.objectiveFitness(crowded1) != g.objectiveFitness(lone) {
+		t.Fatalf("expected objectiveFitness to ignore fitness sharing, got %v vs %v",
+			g.objectiveFitness(crowded1), g.objectiveFitness(lone))
+	}
+}
+
+func TestSpeciesBest_RespectsObjective(t *testing.T) {
+	s := Species{Members: []Chromosome{
+		&testChromosome{key: "a", fitness: 1},
+		&testChromosome{key: "b", fitness: 10},
+	}}
+
+	if got := s.Best(Maximize); got.GetKey() != "b" {
+		t.Fatalf("Maximize: expected the higher-fitness member (b), got %q", got.GetKey())
+	}
+	if got := s.Best(Minimize); got.GetKey() != "a" {
+		t.Fatalf("Minimize: expected the lower-fitness member (a) to map to the higher objective fitness, got %q", got.GetKey())
+	}
+}
@@ -0,0 +1,92 @@
+package goga
+
+import "time"
+
+// StopCriterion decides whether the GA should stop after evaluating a
+// generation's population. fitness reports an individual's objective-mapped
+// fitness (see Goga.objectiveFitness), so criteria that compare fitness
+// values stay correct under both Maximize and Minimize.
+type StopCriterion interface {
+	ShouldStop(gen int, population []Chromosome, best Chromosome, fitness func(Chromosome) float64) bool
+}
+
+// MaxGenerations stops once Gen generations have been run.
+type MaxGenerations struct {
+	Gen int
+}
+
+func (m MaxGenerations) ShouldStop(gen int, population []Chromosome, best Chromosome, fitness func(Chromosome) float64) bool {
+	return gen >= m.Gen-1
+}
+
+// TargetFitness stops as soon as the best chromosome reports itself good
+// enough.
+type TargetFitness struct{}
+
+func (TargetFitness) ShouldStop(gen int, population []Chromosome, best Chromosome, fitness func(Chromosome) float64) bool {
+	return best.IsGoodEnough()
+}
+
+// Stagnation stops once the best fitness hasn't improved in Generations
+// consecutive generations.
+type Stagnation struct {
+	Generations int
+
+	started          bool
+	best             float64
+	sinceImprovement int
+}
+
+func (s *Stagnation) ShouldStop(gen int, population []Chromosome, best Chromosome, fitness func(Chromosome) float64) bool {
+	f := fitness(best)
+	if !s.started || f > s.best {
+		s.started = true
+		s.best = f
+		s.sinceImprovement = 0
+	} else {
+		s.sinceImprovement++
+	}
+	return s.sinceImprovement >= s.Generations
+}
+
+// Deadline stops once Duration has elapsed since the first time it was
+// checked.
+type Deadline struct {
+	Duration time.Duration
+
+	deadline time.Time
+}
+
+func (d *Deadline) ShouldStop(gen int, population []Chromosome, best Chromosome, fitness func(Chromosome) float64) bool {
+	if d.deadline.IsZero() {
+		d.deadline = time.Now().Add(d.Duration)
+	}
+	return time.Now().After(d.deadline)
+}
+
+// Any stops as soon as any of the wrapped criteria want to stop.
+type Any []StopCriterion
+
+func (a Any) ShouldStop(gen int, population []Chromosome, best Chromosome, fitness func(Chromosome) float64) bool {
+	for _, c := range a {
+		if c.ShouldStop(gen, population, best, fitness) {
+			return true
+		}
+	}
+	return false
+}
+
+// All stops only once every wrapped criterion wants to stop.
+type All []StopCriterion
+
+func (a All) ShouldStop(gen int, population []Chromosome, best Chromosome, fitness func(Chromosome) float64) bool {
+	if len(a) == 0 {
+		return false
+	}
+	for _, c := range a {
+		if !c.ShouldStop(gen, population, best, fitness) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package goga
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaxGenerations_StopsOnGenBoundary(t *testing.T) {
+	m := MaxGenerations{Gen: 3}
+	best := &testChromosome{key: "a", fitness: 1}
+
+	for gen := 0; gen < 2; gen++ {
+		if m.ShouldStop(gen, nil, best, identityFitness) {
+			t.Fatalf("gen %d: expected not to stop before reaching Gen", gen)
+		}
+	}
+	if !m.ShouldStop(2, nil, best, identityFitness) {
+		t.Fatalf("gen 2: expected to stop on the (Gen-1)th generation")
+	}
+}
+
+func TestTargetFitness_StopsWhenBestIsGoodEnough(t *testing.T) {
+	tf := TargetFitness{}
+
+	notGood := &testChromosome{key: "a", fitness: 1, good: false}
+	if tf.ShouldStop(0, nil, notGood, identityFitness) {
+		t.Fatalf("expected not to stop while best is not good enough")
+	}
+
+	good := &testChromosome{key: "b", fitness: 100, good: true}
+	if !tf.ShouldStop(0, nil, good, identityFitness) {
+		t.Fatalf("expected to stop once best is good enough")
+	}
+}
+
+func TestStagnation_UsesObjectiveMappedFitness(t *testing.T) {
+	s := &Stagnation{Generations: 2}
+
+	// Raw fitness decreases each generation, but under Minimize that's an
+	// *improvement* once mapped by fitnessFor, so stagnation must not fire.
+	improving := []float64{10, 5, 1}
+	minimize := func(c Chromosome) float64 { return fitnessFor(Minimize, c) }
+
+	for gen, f := range improving {
+		best := &testChromosome{key: "a", fitness: f}
+		if s.ShouldStop(gen, nil, best, minimize) {
+			t.Fatalf("gen %d: expected no stagnation while objective fitness keeps improving", gen)
+		}
+	}
+}
+
+func TestStagnation_StopsAfterNoImprovement(t *testing.T) {
+	s := &Stagnation{Generations: 2}
+	best := &testChromosome{key: "a", fitness: 10}
+
+	if s.ShouldStop(0, nil, best, identityFitness) {
+		t.Fatalf("gen 0: first observation should never itself be stagnation")
+	}
+	if s.ShouldStop(1, nil, best, identityFitness) {
+		t.Fatalf("gen 1: only one generation without improvement, should not stop yet")
+	}
+	if !s.ShouldStop(2, nil, best, identityFitness) {
+		t.Fatalf("gen 2: expected to stop after Generations generations without improvement")
+	}
+}
+
+func TestDeadline_StopsAfterDurationElapses(t *testing.T) {
+	d := &Deadline{Duration: 10 * time.Millisecond}
+	best := &testChromosome{key: "a", fitness: 1}
+
+	if d.ShouldStop(0, nil, best, identityFitness) {
+		t.Fatalf("expected not to stop immediately")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if !d.ShouldStop(1, nil, best, identityFitness) {
+		t.Fatalf("expected to stop once the deadline has elapsed")
+	}
+}
+
+func TestAny_StopsIfAnyCriterionStops(t *testing.T) {
+	best := &testChromosome{key: "a", fitness: 1, good: false}
+	a := Any{MaxGenerations{Gen: 100}, TargetFitness{}}
+
+	if a.ShouldStop(0, nil, best, identityFitness) {
+		t.Fatalf("expected no criterion to want to stop yet")
+	}
+
+	good := &testChromosome{key: "b", fitness: 1, good: true}
+	if !a.ShouldStop(0, nil, good, identityFitness) {
+		t.Fatalf("expected Any to stop once TargetFitness is satisfied")
+	}
+}
+
+func TestAll_StopsOnlyWhenEveryCriterionStops(t *testing.T) {
+	notGood := &testChromosome{key: "a", fitness: 1, good: false}
+	all := All{MaxGenerations{Gen: 1}, TargetFitness{}}
+
+	if all.ShouldStop(0, nil, notGood, identityFitness) {
+		t.Fatalf("expected All not to stop while TargetFitness disagrees")
+	}
+
+	good := &testChromosome{key: "b", fitness: 1, good: true}
+	if !all.ShouldStop(0, nil, good, identityFitness) {
+		t.Fatalf("expected All to stop once every criterion agrees")
+	}
+}
+
+func TestAll_EmptyNeverStops(t *testing.T) {
+	var all All
+	best := &testChromosome{key: "a", fitness: 1}
+	if all.ShouldStop(0, nil, best, identityFitness) {
+		t.Fatalf("expected an empty All to never stop")
+	}
+}